@@ -16,6 +16,7 @@
 #
 import webapp2
 import jinja2
+import hashlib
 import os
 
 JINJA_ENVIRONMENT = jinja2.Environment(
@@ -26,7 +27,15 @@ JINJA_ENVIRONMENT = jinja2.Environment(
 class MainHandler(webapp2.RequestHandler):
     def get(self, req):
         template = JINJA_ENVIRONMENT.get_template('index.html')
-        self.response.write(template.render())
+        body = template.render().encode('utf-8')
+        etag = hashlib.sha1(body).hexdigest()
+
+        self.response.etag = etag
+        if etag in self.request.if_none_match:
+            self.response.status = 304
+            return
+
+        self.response.write(body)
 
 app = webapp2.WSGIApplication([
     ('/(.*)', MainHandler)